@@ -0,0 +1,199 @@
+// Copyright (C) 2021  mieru authors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package appctl
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/enfein/mieru/pkg/appctl/appctlpb"
+)
+
+// testRPCServerCerts writes a self-signed CA, and a server certificate it
+// issued, to PEM files under t.TempDir(), returning the file paths a
+// pb.RpcListener expects.
+func testRPCServerCerts(t *testing.T) (certFile, keyFile, caBundleFile string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey() failed: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate() failed for CA: %v", err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatalf("x509.ParseCertificate() failed for CA: %v", err)
+	}
+
+	serverKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey() failed: %v", err)
+	}
+	serverTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test server"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	serverDER, err := x509.CreateCertificate(rand.Reader, serverTemplate, caCert, &serverKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate() failed for server cert: %v", err)
+	}
+	serverKeyDER, err := x509.MarshalECPrivateKey(serverKey)
+	if err != nil {
+		t.Fatalf("x509.MarshalECPrivateKey() failed: %v", err)
+	}
+
+	certFile = filepath.Join(dir, "server.crt")
+	keyFile = filepath.Join(dir, "server.key")
+	caBundleFile = filepath.Join(dir, "ca.bundle")
+	writePEM(t, certFile, "CERTIFICATE", serverDER)
+	writePEM(t, keyFile, "EC PRIVATE KEY", serverKeyDER)
+	writePEM(t, caBundleFile, "CERTIFICATE", caDER)
+	return certFile, keyFile, caBundleFile
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("os.Create(%q) failed: %v", path, err)
+	}
+	defer f.Close()
+	if err := pem.Encode(f, &pem.Block{Type: blockType, Bytes: der}); err != nil {
+		t.Fatalf("pem.Encode(%q) failed: %v", path, err)
+	}
+}
+
+func TestBuildRPCServerTLSConfig(t *testing.T) {
+	certFile, keyFile, caBundleFile := testRPCServerCerts(t)
+
+	cases := []struct {
+		name     string
+		listener *pb.RpcListener
+		wantErr  bool
+	}{
+		{
+			name:     "nil listener",
+			listener: nil,
+			wantErr:  true,
+		},
+		{
+			name: "bad cert path",
+			listener: &pb.RpcListener{
+				ServerCertFile: filepath.Join(t.TempDir(), "missing.crt"),
+				ServerKeyFile:  keyFile,
+				ClientCaBundle: caBundleFile,
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad CA bundle",
+			listener: &pb.RpcListener{
+				ServerCertFile: certFile,
+				ServerKeyFile:  keyFile,
+				ClientCaBundle: filepath.Join(t.TempDir(), "missing.bundle"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "happy path",
+			listener: &pb.RpcListener{
+				Addr:           "127.0.0.1:0",
+				ServerCertFile: certFile,
+				ServerKeyFile:  keyFile,
+				ClientCaBundle: caBundleFile,
+			},
+			wantErr: false,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tlsConfig, err := BuildRPCServerTLSConfig(c.listener)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("BuildRPCServerTLSConfig() succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BuildRPCServerTLSConfig() failed: %v", err)
+			}
+			if len(tlsConfig.Certificates) != 1 {
+				t.Errorf("len(tlsConfig.Certificates) = %d, want 1", len(tlsConfig.Certificates))
+			}
+			if tlsConfig.ClientAuth != tls.RequireAndVerifyClientCert {
+				t.Errorf("tlsConfig.ClientAuth = %v, want %v", tlsConfig.ClientAuth, tls.RequireAndVerifyClientCert)
+			}
+			if tlsConfig.ClientCAs == nil {
+				t.Errorf("tlsConfig.ClientCAs is nil, want the parsed CA bundle")
+			}
+		})
+	}
+}
+
+func TestNewTCPRPCServerBindsListener(t *testing.T) {
+	certFile, keyFile, caBundleFile := testRPCServerCerts(t)
+	rpcListener := &pb.RpcListener{
+		Addr:           "127.0.0.1:0",
+		ServerCertFile: certFile,
+		ServerKeyFile:  keyFile,
+		ClientCaBundle: caBundleFile,
+	}
+
+	tcpServer, err := newTCPRPCServer(rpcListener)
+	if err != nil {
+		t.Fatalf("newTCPRPCServer() failed: %v", err)
+	}
+	defer tcpServer.listener.Close()
+	if tcpServer.server == nil {
+		t.Errorf("tcpServer.server is nil")
+	}
+	if tcpServer.listener.Addr().String() == "" {
+		t.Errorf("tcpServer.listener is not bound to an address")
+	}
+}
+
+func TestNewTCPRPCServerRejectsBadListenerConfig(t *testing.T) {
+	if _, err := newTCPRPCServer(&pb.RpcListener{Addr: "127.0.0.1:0"}); err == nil {
+		t.Fatalf("newTCPRPCServer() succeeded with no cert/key configured, want error")
+	}
+}
@@ -17,13 +17,18 @@ package appctl
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	pb "github.com/enfein/mieru/pkg/appctl/appctlpb"
 	"github.com/enfein/mieru/pkg/log"
@@ -33,6 +38,9 @@ import (
 	"github.com/enfein/mieru/pkg/stderror"
 	"github.com/enfein/mieru/pkg/udpsession"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -52,10 +60,56 @@ var (
 	// serverRPCServerRef holds a pointer to server RPC server.
 	serverRPCServerRef atomic.Value
 
+	// serverTCPRPCServerRef holds a pointer to the additional mTLS RPC
+	// server StartRPCServer binds to a pb.RpcListener's TCP address, if one
+	// is configured. It is nil when no RpcListener is configured.
+	serverTCPRPCServerRef atomic.Value
+
 	// socks5ServerGroup is a collection of server socks5 servers.
 	socks5ServerGroup = socks5.NewGroup()
+
+	// activePortBindingsMu guards activePortBindings and udpListeners.
+	activePortBindingsMu sync.Mutex
+
+	// reloadMu serializes the validate/store/reloadPortBindings sequence in
+	// Reload and SetConfig's reload-in-place path. Without it, two concurrent
+	// requests could both observe AppStatus_RUNNING before either flips the
+	// status to AppStatus_RELOADING, then race each other through
+	// StoreServerConfig and reloadPortBindings.
+	reloadMu sync.Mutex
+
+	// activePortBindings records the port bindings that are currently served,
+	// keyed by portBindingKey(). It lets Reload() diff the running state
+	// against a new pb.ServerConfig.
+	activePortBindings = map[string]*pb.PortBinding{}
+
+	// udpListeners records the udpsession.Listener backing each active port
+	// binding, keyed by portBindingKey(), so Reload() can push a new user
+	// list into it without restarting the listener.
+	udpListeners = map[string]*udpsession.Listener{}
 )
 
+// portBindingKey builds the map key used by activePortBindings and udpListeners.
+func portBindingKey(protocol string, port int) string {
+	return protocol + ":" + strconv.Itoa(port)
+}
+
+// newRequestLogger derives a structured per-request logger for an RPC
+// handler. It carries a request id and the caller's peer address, so every
+// log line produced by a single admin action - across config load, listener
+// bring-up, and the eventual response - can be correlated by request_id.
+func newRequestLogger(ctx context.Context, rpc string) *log.Logger {
+	peerAddr := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerAddr = p.Addr.String()
+	}
+	return log.New().WithFields(log.Fields{
+		"rpc":        rpc,
+		"request_id": log.NextRequestID(),
+		"peer":       peerAddr,
+	})
+}
+
 func GetServerRPCServerRef() *grpc.Server {
 	s, ok := serverRPCServerRef.Load().(*grpc.Server)
 	if !ok {
@@ -68,10 +122,125 @@ func SetServerRPCServerRef(server *grpc.Server) {
 	serverRPCServerRef.Store(server)
 }
 
+// GetServerTCPRPCServerRef returns the mTLS RPC server StartRPCServer bound
+// to a pb.RpcListener's TCP address, or nil if no RpcListener is configured.
+func GetServerTCPRPCServerRef() *grpc.Server {
+	s, ok := serverTCPRPCServerRef.Load().(*grpc.Server)
+	if !ok {
+		return nil
+	}
+	return s
+}
+
+func SetServerTCPRPCServerRef(server *grpc.Server) {
+	serverTCPRPCServerRef.Store(server)
+}
+
 func GetSocks5ServerGroup() *socks5.ServerGroup {
 	return socks5ServerGroup
 }
 
+// BuildRPCServerTLSConfig constructs the server-side mutual TLS configuration
+// described by a pb.RpcListener, so the RPC server can additionally bind to a
+// TCP address for remote management, alongside the default ServerUDS. Clients
+// must present a certificate signed by the configured client CA bundle.
+func BuildRPCServerTLSConfig(listener *pb.RpcListener) (*tls.Config, error) {
+	if listener == nil {
+		return nil, fmt.Errorf("RpcListener is nil")
+	}
+	cert, err := tls.LoadX509KeyPair(listener.GetServerCertFile(), listener.GetServerKeyFile())
+	if err != nil {
+		return nil, fmt.Errorf("tls.LoadX509KeyPair() failed: %w", err)
+	}
+	caBundle, err := ioutil.ReadFile(listener.GetClientCaBundle())
+	if err != nil {
+		return nil, fmt.Errorf("ReadFile(%q) failed: %w", listener.GetClientCaBundle(), err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caBundle) {
+		return nil, fmt.Errorf("failed to parse client CA bundle %q", listener.GetClientCaBundle())
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    caPool,
+	}, nil
+}
+
+// StartRPCServer brings up the RPC server that exposes ServerLifecycleService,
+// ServerConfigService and UserManagementService. It always binds to ServerUDS.
+// If config also carries a pb.RpcListener, it additionally binds to that TCP
+// address with the mutual TLS configuration built by BuildRPCServerTLSConfig,
+// so the daemon can be managed remotely. SetServerRPCServerRef and
+// SetServerTCPRPCServerRef record the two servers, so Exit() can stop both.
+func StartRPCServer(config *pb.ServerConfig) error {
+	udsServer := grpc.NewServer()
+	registerAppctlServices(udsServer)
+	udsListener, err := net.Listen("unix", ServerUDS)
+	if err != nil {
+		return fmt.Errorf("net.Listen(%q) failed: %w", ServerUDS, err)
+	}
+	SetServerRPCServerRef(udsServer)
+	go func() {
+		if err := udsServer.Serve(udsListener); err != nil {
+			log.Fatalf("RPC server on %q failed: %v", ServerUDS, err)
+		}
+	}()
+
+	rpcListener := config.GetAdvancedSettings().GetRpcListener()
+	if rpcListener != nil {
+		tcpServer, err := newTCPRPCServer(rpcListener)
+		if err != nil {
+			// The UDS server is already serving; stop it rather than leave
+			// it as an orphaned goroutine no caller knows to stop.
+			udsServer.GracefulStop()
+			return fmt.Errorf("newTCPRPCServer() failed: %w", err)
+		}
+		SetServerTCPRPCServerRef(tcpServer.server)
+		go func() {
+			if err := tcpServer.server.Serve(tcpServer.listener); err != nil {
+				log.Fatalf("RPC server on %q failed: %v", rpcListener.GetAddr(), err)
+			}
+		}()
+	}
+
+	// Both configured listeners, if any, are serving by this point.
+	close(ServerRPCServerStarted)
+	return nil
+}
+
+// tcpRPCServer bundles a TCP RPC server with the listener it is about to
+// serve, so StartRPCServer can start Serve() in a goroutine after recording
+// the server reference.
+type tcpRPCServer struct {
+	server   *grpc.Server
+	listener net.Listener
+}
+
+// newTCPRPCServer builds the mTLS-secured gRPC server and TCP listener
+// described by rpcListener, ready for server.Serve(listener).
+func newTCPRPCServer(rpcListener *pb.RpcListener) (*tcpRPCServer, error) {
+	tlsConfig, err := BuildRPCServerTLSConfig(rpcListener)
+	if err != nil {
+		return nil, fmt.Errorf("BuildRPCServerTLSConfig() failed: %w", err)
+	}
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	registerAppctlServices(server)
+	listener, err := net.Listen("tcp", rpcListener.GetAddr())
+	if err != nil {
+		return nil, fmt.Errorf("net.Listen(%q) failed: %w", rpcListener.GetAddr(), err)
+	}
+	return &tcpRPCServer{server: server, listener: listener}, nil
+}
+
+// registerAppctlServices registers the RPC services served by StartRPCServer
+// on server.
+func registerAppctlServices(server *grpc.Server) {
+	pb.RegisterServerLifecycleServiceServer(server, NewServerLifecycleService())
+	pb.RegisterServerConfigServiceServer(server, NewServerConfigService())
+	pb.RegisterUserManagementServiceServer(server, NewUserManagementService())
+}
+
 // serverLifecycleService implements ServerLifecycleService defined in lifecycle.proto.
 type serverLifecycleService struct {
 	pb.UnimplementedServerLifecycleServiceServer
@@ -79,16 +248,21 @@ type serverLifecycleService struct {
 
 func (s *serverLifecycleService) GetStatus(ctx context.Context, req *pb.Empty) (*pb.AppStatusMsg, error) {
 	status := GetAppStatus()
-	log.Infof("return app status %s back to RPC caller", status.String())
+	rlog := newRequestLogger(ctx, "GetStatus").WithFields(log.Fields{"app_status": status.String()})
+	rlog.Infof("return app status %s back to RPC caller", status.String())
 	return &pb.AppStatusMsg{Status: status}, nil
 }
 
 func (s *serverLifecycleService) Start(ctx context.Context, req *pb.Empty) (*pb.Empty, error) {
-	log.Infof("received start request from RPC caller")
+	rlog := newRequestLogger(ctx, "Start")
+	rlog.Infof("received start request from RPC caller")
 	config, err := LoadServerConfig()
 	if err != nil {
 		return &pb.Empty{}, fmt.Errorf("LoadServerConfig() failed: %w", err)
 	}
+	n := len(config.GetPortBindings())
+	rlog = rlog.WithFields(log.Fields{"port_bindings": n, "user_count": len(config.GetUsers())})
+
 	loggingLevel := config.GetLoggingLevel().String()
 	if loggingLevel != pb.LoggingLevel_DEFAULT.String() {
 		log.SetLevel(loggingLevel)
@@ -97,11 +271,10 @@ func (s *serverLifecycleService) Start(ctx context.Context, req *pb.Empty) (*pb.
 		return &pb.Empty{}, fmt.Errorf("ValidateFullServerConfig() failed: %w", err)
 	}
 	if !GetSocks5ServerGroup().IsEmpty() {
-		log.Infof("socks5 server(s) already exist")
+		rlog.Infof("socks5 server(s) already exist")
 		return &pb.Empty{}, nil
 	}
 
-	n := len(config.GetPortBindings())
 	var initProxyTasks sync.WaitGroup
 	initProxyTasks.Add(n)
 	SetAppStatus(pb.AppStatus_STARTING)
@@ -115,8 +288,9 @@ func (s *serverLifecycleService) Start(ctx context.Context, req *pb.Empty) (*pb.
 		if err != nil {
 			return &pb.Empty{}, fmt.Errorf(stderror.CreateSocks5ServerFailedErr, err)
 		}
-		protocol := config.GetPortBindings()[i].GetProtocol().String()
-		port := config.GetPortBindings()[i].GetPort()
+		binding := config.GetPortBindings()[i]
+		protocol := binding.GetProtocol().String()
+		port := binding.GetPort()
 		if err := GetSocks5ServerGroup().Add(protocol, int(port), socks5Server); err != nil {
 			return &pb.Empty{}, fmt.Errorf(stderror.AddSocks5ServerToGroupFailedErr, err)
 		}
@@ -126,61 +300,220 @@ func (s *serverLifecycleService) Start(ctx context.Context, req *pb.Empty) (*pb.
 			socks5Addr := netutil.MaybeDecorateIPv6(netutil.AllIPAddr()) + ":" + strconv.Itoa(int(port))
 			l, err := udpsession.ListenWithOptions(socks5Addr, UserListToMap(config.GetUsers()))
 			if err != nil {
-				log.Fatalf("udpsession.ListenWithOptions(%q) failed: %v", socks5Addr, err)
+				rlog.Fatalf("udpsession.ListenWithOptions(%q) failed: %v", socks5Addr, err)
 			}
+			activePortBindingsMu.Lock()
+			activePortBindings[portBindingKey(protocol, int(port))] = binding
+			udpListeners[portBindingKey(protocol, int(port))] = l
+			activePortBindingsMu.Unlock()
 			initProxyTasks.Done()
-			log.Infof("mieru server daemon socks5 server %q is running", socks5Addr)
+			rlog.Infof("mieru server daemon socks5 server %q is running", socks5Addr)
 			if err = socks5Server.Serve(l); err != nil {
-				log.Fatalf("run socks5 server %q failed: %v", socks5Addr, err)
+				rlog.Fatalf("run socks5 server %q failed: %v", socks5Addr, err)
 			}
-			log.Infof("mieru server daemon socks5 server %q is stopped", socks5Addr)
+			rlog.Infof("mieru server daemon socks5 server %q is stopped", socks5Addr)
 		}()
 	}
 
 	initProxyTasks.Wait()
 	metrics.EnableLogging()
 	SetAppStatus(pb.AppStatus_RUNNING)
-	log.Infof("completed start request from RPC caller")
+	rlog.WithFields(log.Fields{"app_status": pb.AppStatus_RUNNING.String()}).Infof("completed start request from RPC caller")
 	return &pb.Empty{}, nil
 }
 
+// Reload diffs req against the port bindings currently being served and
+// brings the running socks5 servers in line with it, without tearing down
+// bindings that are unchanged. This lets an operator push a new
+// pb.ServerConfig while tunnels using unaffected port bindings stay up.
+func (s *serverLifecycleService) Reload(ctx context.Context, req *pb.ServerConfig) (*pb.Empty, error) {
+	rlog := newRequestLogger(ctx, "Reload").WithFields(log.Fields{
+		"app_status":    GetAppStatus().String(),
+		"port_bindings": len(req.GetPortBindings()),
+		"user_count":    len(req.GetUsers()),
+	})
+	rlog.Infof("received reload request from RPC caller")
+	unlock, err := beginReload()
+	if err != nil {
+		return &pb.Empty{}, err
+	}
+	defer unlock()
+	if err := ValidateFullServerConfig(req); err != nil {
+		return &pb.Empty{}, fmt.Errorf("ValidateFullServerConfig() failed: %w", err)
+	}
+	if err := StoreServerConfig(req); err != nil {
+		return &pb.Empty{}, fmt.Errorf("StoreServerConfig() failed: %w", err)
+	}
+	config, err := LoadServerConfig()
+	if err != nil {
+		return &pb.Empty{}, fmt.Errorf("LoadServerConfig() failed: %w", err)
+	}
+	if err := reloadPortBindings(log.NewContext(ctx, rlog), config); err != nil {
+		return &pb.Empty{}, fmt.Errorf("reloadPortBindings() failed: %w", err)
+	}
+	rlog.Infof("completed reload request from RPC caller")
+	return &pb.Empty{}, nil
+}
+
+// beginReload acquires reloadMu without blocking and re-checks that the
+// daemon is still AppStatus_RUNNING once it holds the lock, so a second
+// concurrent Reload/SetConfig request fails fast instead of racing the first
+// one through ValidateFullServerConfig/StoreServerConfig/reloadPortBindings.
+// The caller must invoke the returned unlock func once it is done, but only
+// when err is nil.
+func beginReload() (unlock func(), err error) {
+	if !reloadMu.TryLock() {
+		return nil, fmt.Errorf("another reload is already in progress")
+	}
+	if GetAppStatus() != pb.AppStatus_RUNNING {
+		reloadMu.Unlock()
+		return nil, fmt.Errorf("reload is only supported when mieru server is %q, current status is %q", pb.AppStatus_RUNNING, GetAppStatus())
+	}
+	return reloadMu.Unlock, nil
+}
+
+// reloadPortBindings brings the running socks5 servers in line with config:
+// port bindings that are unchanged keep their existing udpsession.Listener
+// and socks5.Server and just receive the new user list, removed bindings are
+// drained via socks5.ServerGroup.Remove(), and new bindings are started the
+// same way serverLifecycleService.Start() does. The per-request logger of
+// the caller (Reload or SetConfig) is carried on ctx, so the log lines this
+// emits correlate with that RPC call.
+func reloadPortBindings(ctx context.Context, config *pb.ServerConfig) (err error) {
+	rlog := log.FromContext(ctx)
+	SetAppStatus(pb.AppStatus_RELOADING)
+	defer func() {
+		if err != nil {
+			// The port bindings removal/addition loops below may have only
+			// partially applied, so activePortBindings now matches neither
+			// the old nor the new ServerConfig. Report AppStatus_BROKEN
+			// rather than RUNNING so callers can tell the daemon needs a
+			// restart, instead of trusting a half-migrated binding set.
+			SetAppStatus(pb.AppStatus_BROKEN)
+			rlog.Errorf("reloadPortBindings() failed, daemon is in a broken state: %v", err)
+			return
+		}
+		SetAppStatus(pb.AppStatus_RUNNING)
+	}()
+
+	group := GetSocks5ServerGroup()
+	userMap := UserListToMap(config.GetUsers())
+
+	desired := map[string]*pb.PortBinding{}
+	for _, binding := range config.GetPortBindings() {
+		desired[portBindingKey(binding.GetProtocol().String(), int(binding.GetPort()))] = binding
+	}
+
+	activePortBindingsMu.Lock()
+	defer activePortBindingsMu.Unlock()
+
+	for key, binding := range activePortBindings {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if err := group.Remove(binding.GetProtocol().String(), int(binding.GetPort())); err != nil {
+			return fmt.Errorf("socks5.ServerGroup.Remove() failed: %w", err)
+		}
+		delete(udpListeners, key)
+		delete(activePortBindings, key)
+		rlog.Infof("removed socks5 server on port %d", binding.GetPort())
+	}
+
+	for key := range activePortBindings {
+		udpListeners[key].UserListToMap(userMap)
+	}
+
+	for key, binding := range desired {
+		if _, ok := activePortBindings[key]; ok {
+			continue
+		}
+		socks5Config := &socks5.Config{
+			AllowLocalDestination: config.GetAdvancedSettings().GetAllowLocalDestination(),
+		}
+		socks5Server, err := socks5.New(socks5Config)
+		if err != nil {
+			return fmt.Errorf(stderror.CreateSocks5ServerFailedErr, err)
+		}
+		protocol := binding.GetProtocol().String()
+		port := int(binding.GetPort())
+		if err := group.Add(protocol, port, socks5Server); err != nil {
+			return fmt.Errorf(stderror.AddSocks5ServerToGroupFailedErr, err)
+		}
+		socks5Addr := netutil.MaybeDecorateIPv6(netutil.AllIPAddr()) + ":" + strconv.Itoa(port)
+		l, err := udpsession.ListenWithOptions(socks5Addr, userMap)
+		if err != nil {
+			return fmt.Errorf("udpsession.ListenWithOptions(%q) failed: %w", socks5Addr, err)
+		}
+		activePortBindings[key] = binding
+		udpListeners[key] = l
+
+		go func() {
+			rlog.Infof("mieru server daemon socks5 server %q is running", socks5Addr)
+			if err := socks5Server.Serve(l); err != nil {
+				rlog.Fatalf("run socks5 server %q failed: %v", socks5Addr, err)
+			}
+			rlog.Infof("mieru server daemon socks5 server %q is stopped", socks5Addr)
+		}()
+		rlog.Infof("added socks5 server on port %d", port)
+	}
+
+	return nil
+}
+
+// clearActivePortBindings forgets the port bindings and listeners tracked for
+// Reload(), after all socks5 servers have been closed.
+func clearActivePortBindings() {
+	activePortBindingsMu.Lock()
+	defer activePortBindingsMu.Unlock()
+	activePortBindings = map[string]*pb.PortBinding{}
+	udpListeners = map[string]*udpsession.Listener{}
+}
+
 func (s *serverLifecycleService) Stop(ctx context.Context, req *pb.Empty) (*pb.Empty, error) {
+	rlog := newRequestLogger(ctx, "Stop")
 	SetAppStatus(pb.AppStatus_STOPPING)
-	log.Infof("received stop request from RPC caller")
+	rlog.WithFields(log.Fields{"app_status": pb.AppStatus_STOPPING.String()}).Infof("received stop request from RPC caller")
 	if !GetSocks5ServerGroup().IsEmpty() {
-		log.Infof("stopping socks5 server(s)")
+		rlog.Infof("stopping socks5 server(s)")
 		if err := GetSocks5ServerGroup().CloseAndRemoveAll(); err != nil {
-			log.Infof("socks5 server Close() failed: %v", err)
+			rlog.Infof("socks5 server Close() failed: %v", err)
 		}
 	} else {
-		log.Infof("active socks5 servers not found")
+		rlog.Infof("active socks5 servers not found")
 	}
+	clearActivePortBindings()
 	SetAppStatus(pb.AppStatus_IDLE)
-	log.Infof("completed stop request from RPC caller")
+	rlog.WithFields(log.Fields{"app_status": pb.AppStatus_IDLE.String()}).Infof("completed stop request from RPC caller")
 	return &pb.Empty{}, nil
 }
 
 func (s *serverLifecycleService) Exit(ctx context.Context, req *pb.Empty) (*pb.Empty, error) {
+	rlog := newRequestLogger(ctx, "Exit")
 	SetAppStatus(pb.AppStatus_STOPPING)
-	log.Infof("received exit request from RPC caller")
+	rlog.WithFields(log.Fields{"app_status": pb.AppStatus_STOPPING.String()}).Infof("received exit request from RPC caller")
 	if !GetSocks5ServerGroup().IsEmpty() {
-		log.Infof("stopping socks5 server(s)")
+		rlog.Infof("stopping socks5 server(s)")
 		if err := GetSocks5ServerGroup().CloseAndRemoveAll(); err != nil {
-			log.Infof("socks5 server Close() failed: %v", err)
+			rlog.Infof("socks5 server Close() failed: %v", err)
 		}
 	} else {
-		log.Infof("active socks5 servers not found")
+		rlog.Infof("active socks5 servers not found")
 	}
+	clearActivePortBindings()
 	SetAppStatus(pb.AppStatus_IDLE)
 
 	grpcServer := GetServerRPCServerRef()
 	if grpcServer != nil {
-		log.Infof("stopping RPC server")
+		rlog.Infof("stopping RPC server")
 		go grpcServer.GracefulStop()
 	} else {
-		log.Infof("RPC server reference not found")
+		rlog.Infof("RPC server reference not found")
 	}
-	log.Infof("completed exit request from RPC caller")
+	if tcpServer := GetServerTCPRPCServerRef(); tcpServer != nil {
+		rlog.Infof("stopping TCP RPC server")
+		go tcpServer.GracefulStop()
+	}
+	rlog.WithFields(log.Fields{"app_status": pb.AppStatus_IDLE.String()}).Infof("completed exit request from RPC caller")
 	return &pb.Empty{}, nil
 }
 
@@ -203,19 +536,102 @@ func (s *serverLifecycleService) GetHeapProfile(ctx context.Context, req *pb.Pro
 	return &pb.Empty{}, err
 }
 
+// defaultMetricsStreamInterval is used when MetricsRequest doesn't set
+// a positive interval.
+const defaultMetricsStreamInterval = 5 * time.Second
+
+// StreamMetrics periodically sends a snapshot of the counters and gauges
+// produced by pkg/metrics to the caller, until the request is canceled.
+func (s *serverLifecycleService) StreamMetrics(req *pb.MetricsRequest, stream pb.ServerLifecycleService_StreamMetricsServer) error {
+	interval := time.Duration(req.GetIntervalSeconds()) * time.Second
+	if interval <= 0 {
+		interval = defaultMetricsStreamInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	send := func() error {
+		snapshot := metrics.GetAllMetrics(req.GetNameFilter())
+		return stream.Send(snapshot)
+	}
+	if err := send(); err != nil {
+		return fmt.Errorf("stream.Send() failed: %w", err)
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+			if err := send(); err != nil {
+				return fmt.Errorf("stream.Send() failed: %w", err)
+			}
+		}
+	}
+}
+
+// StreamLogs tees pkg/log output at the caller-selected level to the caller,
+// until the request is canceled.
+func (s *serverLifecycleService) StreamLogs(req *pb.LogRequest, stream pb.ServerLifecycleService_StreamLogsServer) error {
+	minLevel, err := log.ParseLevel(req.GetLevel().String())
+	if err != nil {
+		return fmt.Errorf("log.ParseLevel() failed: %w", err)
+	}
+	records, unsubscribe := log.Subscribe(minLevel)
+	defer unsubscribe()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case record, ok := <-records:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(toPBLogRecord(record)); err != nil {
+				return fmt.Errorf("stream.Send() failed: %w", err)
+			}
+		}
+	}
+}
+
+// toPBLogRecord converts a pkg/log Record into the pb.LogRecord shape sent
+// over StreamLogs.
+func toPBLogRecord(record *log.Record) *pb.LogRecord {
+	fields := make(map[string]string, len(record.Fields))
+	for k, v := range record.Fields {
+		fields[k] = fmt.Sprintf("%v", v)
+	}
+	return &pb.LogRecord{
+		UnixNano: record.Time.UnixNano(),
+		Level:    record.Level.String(),
+		Message:  record.Message,
+		Fields:   fields,
+	}
+}
+
 // NewServerLifecycleService creates a new ServerLifecycleService RPC server.
 func NewServerLifecycleService() *serverLifecycleService {
 	return &serverLifecycleService{}
 }
 
-// NewServerLifecycleRPCClient creates a new ServerLifecycleService RPC client.
+// NewServerLifecycleRPCClient creates a new ServerLifecycleService RPC client
+// connected to the local ServerUDS unix domain socket.
 func NewServerLifecycleRPCClient() (pb.ServerLifecycleServiceClient, error) {
-	rpcAddr := "unix://" + ServerUDS
-	timedctx, cancelFunc := context.WithTimeout(context.Background(), RPCTimeout())
-	defer cancelFunc()
-	conn, err := grpc.DialContext(timedctx, rpcAddr, grpc.WithInsecure())
+	return NewServerLifecycleRPCClientWithConfig("unix://"+ServerUDS, nil)
+}
+
+// NewServerLifecycleRPCClientWithConfig creates a new ServerLifecycleService RPC
+// client connected to addr, using tlsConfig to secure the connection (nil for
+// the unauthenticated local ServerUDS unix domain socket).
+func NewServerLifecycleRPCClientWithConfig(addr string, tlsConfig *tls.Config) (pb.ServerLifecycleServiceClient, error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
 	if err != nil {
-		return nil, fmt.Errorf("grpc.DialContext() failed: %w", err)
+		return nil, fmt.Errorf("grpc.NewClient() failed: %w", err)
 	}
 	return pb.NewServerLifecycleServiceClient(conn), nil
 }
@@ -226,14 +642,50 @@ type serverConfigService struct {
 }
 
 func (s *serverConfigService) GetConfig(ctx context.Context, req *pb.Empty) (*pb.ServerConfig, error) {
+	rlog := newRequestLogger(ctx, "GetConfig").WithFields(log.Fields{"app_status": GetAppStatus().String()})
+	rlog.Infof("received get config request from RPC caller")
 	config, err := LoadServerConfig()
 	if err != nil {
 		return &pb.ServerConfig{}, fmt.Errorf("LoadServerConfig() failed: %w", err)
 	}
+	rlog.Infof("completed get config request from RPC caller")
 	return config, nil
 }
 
 func (s *serverConfigService) SetConfig(ctx context.Context, req *pb.ServerConfig) (*pb.ServerConfig, error) {
+	rlog := newRequestLogger(ctx, "SetConfig").WithFields(log.Fields{
+		"app_status":    GetAppStatus().String(),
+		"port_bindings": len(req.GetPortBindings()),
+		"user_count":    len(req.GetUsers()),
+	})
+	rlog.Infof("received set config request from RPC caller")
+
+	// When the daemon is already serving traffic, reload port bindings in
+	// place instead of just writing the config to disk, so unaffected
+	// bindings don't lose their in-flight tunnels.
+	if GetAppStatus() == pb.AppStatus_RUNNING {
+		unlock, err := beginReload()
+		if err != nil {
+			return &pb.ServerConfig{}, err
+		}
+		defer unlock()
+		if err := ValidateFullServerConfig(req); err != nil {
+			return &pb.ServerConfig{}, fmt.Errorf("ValidateFullServerConfig() failed: %w", err)
+		}
+		if err := StoreServerConfig(req); err != nil {
+			return &pb.ServerConfig{}, fmt.Errorf("StoreServerConfig() failed: %w", err)
+		}
+		config, err := LoadServerConfig()
+		if err != nil {
+			return &pb.ServerConfig{}, fmt.Errorf("LoadServerConfig() failed: %w", err)
+		}
+		if err := reloadPortBindings(log.NewContext(ctx, rlog), config); err != nil {
+			return &pb.ServerConfig{}, fmt.Errorf("reloadPortBindings() failed: %w", err)
+		}
+		rlog.Infof("completed set config request from RPC caller")
+		return config, nil
+	}
+
 	if err := StoreServerConfig(req); err != nil {
 		return &pb.ServerConfig{}, fmt.Errorf("StoreServerConfig() failed: %w", err)
 	}
@@ -241,6 +693,7 @@ func (s *serverConfigService) SetConfig(ctx context.Context, req *pb.ServerConfi
 	if err != nil {
 		return &pb.ServerConfig{}, fmt.Errorf("LoadServerConfig() failed: %w", err)
 	}
+	rlog.Infof("completed set config request from RPC caller")
 	return config, nil
 }
 
@@ -249,18 +702,199 @@ func NewServerConfigService() *serverConfigService {
 	return &serverConfigService{}
 }
 
-// NewServerConfigRPCClient creates a new ServerConfigService RPC client.
+// NewServerConfigRPCClient creates a new ServerConfigService RPC client
+// connected to the local ServerUDS unix domain socket.
 func NewServerConfigRPCClient() (pb.ServerConfigServiceClient, error) {
-	rpcAddr := "unix://" + ServerUDS
-	timedctx, cancelFunc := context.WithTimeout(context.Background(), RPCTimeout())
-	defer cancelFunc()
-	conn, err := grpc.DialContext(timedctx, rpcAddr, grpc.WithInsecure())
+	return NewServerConfigRPCClientWithConfig("unix://"+ServerUDS, nil)
+}
+
+// NewServerConfigRPCClientWithConfig creates a new ServerConfigService RPC
+// client connected to addr, reaching a remote pb.RpcListener when tlsConfig
+// secures the connection with mutual TLS, or the local ServerUDS unix domain
+// socket when tlsConfig is nil.
+func NewServerConfigRPCClientWithConfig(addr string, tlsConfig *tls.Config) (pb.ServerConfigServiceClient, error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
 	if err != nil {
-		return nil, fmt.Errorf("grpc.DialContext() failed: %w", err)
+		return nil, fmt.Errorf("grpc.NewClient() failed: %w", err)
 	}
 	return pb.NewServerConfigServiceClient(conn), nil
 }
 
+// maxListUsersPageSize is the default and maximum number of users returned by
+// a single UserManagementService.ListUsers() call.
+const maxListUsersPageSize = 100
+
+// userManagementService implements UserManagementService defined in usermgmt.proto.
+type userManagementService struct {
+	pb.UnimplementedUserManagementServiceServer
+}
+
+func (s *userManagementService) AddUser(ctx context.Context, req *pb.User) (*pb.Empty, error) {
+	serverIOLock.Lock()
+	defer serverIOLock.Unlock()
+
+	config, err := loadServerConfigLocked()
+	if err != nil {
+		return &pb.Empty{}, fmt.Errorf("loadServerConfigLocked() failed: %w", err)
+	}
+	for _, user := range config.GetUsers() {
+		if user.GetName() == req.GetName() {
+			return &pb.Empty{}, fmt.Errorf("user %q already exists", req.GetName())
+		}
+	}
+	config.Users = append(config.Users, req)
+	if err := ValidateServerConfigPatch(config); err != nil {
+		return &pb.Empty{}, fmt.Errorf("ValidateServerConfigPatch() failed: %w", err)
+	}
+	if err := storeServerConfigLocked(config); err != nil {
+		return &pb.Empty{}, fmt.Errorf("storeServerConfigLocked() failed: %w", err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *userManagementService) UpdateUser(ctx context.Context, req *pb.User) (*pb.Empty, error) {
+	serverIOLock.Lock()
+	defer serverIOLock.Unlock()
+
+	config, err := loadServerConfigLocked()
+	if err != nil {
+		return &pb.Empty{}, fmt.Errorf("loadServerConfigLocked() failed: %w", err)
+	}
+	found := false
+	for i, user := range config.GetUsers() {
+		if user.GetName() == req.GetName() {
+			config.Users[i] = req
+			found = true
+			break
+		}
+	}
+	if !found {
+		return &pb.Empty{}, fmt.Errorf("user %q is not found", req.GetName())
+	}
+	if err := ValidateServerConfigPatch(config); err != nil {
+		return &pb.Empty{}, fmt.Errorf("ValidateServerConfigPatch() failed: %w", err)
+	}
+	if err := storeServerConfigLocked(config); err != nil {
+		return &pb.Empty{}, fmt.Errorf("storeServerConfigLocked() failed: %w", err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *userManagementService) DeleteUser(ctx context.Context, req *pb.UserName) (*pb.Empty, error) {
+	serverIOLock.Lock()
+	defer serverIOLock.Unlock()
+
+	config, err := loadServerConfigLocked()
+	if err != nil {
+		return &pb.Empty{}, fmt.Errorf("loadServerConfigLocked() failed: %w", err)
+	}
+	remaining := make([]*pb.User, 0, len(config.GetUsers()))
+	found := false
+	for _, user := range config.GetUsers() {
+		if user.GetName() == req.GetName() {
+			found = true
+			continue
+		}
+		remaining = append(remaining, user)
+	}
+	if !found {
+		return &pb.Empty{}, fmt.Errorf("user %q is not found", req.GetName())
+	}
+	config.Users = remaining
+	if err := storeServerConfigLocked(config); err != nil {
+		return &pb.Empty{}, fmt.Errorf("storeServerConfigLocked() failed: %w", err)
+	}
+	return &pb.Empty{}, nil
+}
+
+// ListUsers returns users whose name has the requested prefix, in a page of
+// at most maxListUsersPageSize users. Callers paginate by feeding the
+// returned NextPageToken back into the following request until it is empty.
+func (s *userManagementService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	config, err := LoadServerConfig()
+	if err != nil {
+		return nil, fmt.Errorf("LoadServerConfig() failed: %w", err)
+	}
+
+	filtered := make([]*pb.User, 0, len(config.GetUsers()))
+	for _, user := range config.GetUsers() {
+		if req.GetNamePrefix() != "" && !strings.HasPrefix(user.GetName(), req.GetNamePrefix()) {
+			continue
+		}
+		filtered = append(filtered, user)
+	}
+	sort.Slice(filtered, func(i, j int) bool { return filtered[i].GetName() < filtered[j].GetName() })
+
+	start := 0
+	if req.GetPageToken() != "" {
+		start, err = strconv.Atoi(req.GetPageToken())
+		if err != nil {
+			return nil, fmt.Errorf("invalid page token %q", req.GetPageToken())
+		}
+	}
+	if start < 0 || start > len(filtered) {
+		return nil, fmt.Errorf("page token %q is out of range", req.GetPageToken())
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 || pageSize > maxListUsersPageSize {
+		pageSize = maxListUsersPageSize
+	}
+	end := start + pageSize
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	resp := &pb.ListUsersResponse{Users: filtered[start:end]}
+	if end < len(filtered) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+// RotatePassword assigns a new plaintext password to a user and re-hashes it
+// server-side, so the plaintext is never written back into the config blob.
+func (s *userManagementService) RotatePassword(ctx context.Context, req *pb.RotatePasswordRequest) (*pb.Empty, error) {
+	if req.GetNewPassword() == "" {
+		return &pb.Empty{}, fmt.Errorf("new password is not set")
+	}
+
+	serverIOLock.Lock()
+	defer serverIOLock.Unlock()
+
+	config, err := loadServerConfigLocked()
+	if err != nil {
+		return &pb.Empty{}, fmt.Errorf("loadServerConfigLocked() failed: %w", err)
+	}
+	found := false
+	for _, user := range config.GetUsers() {
+		if user.GetName() == req.GetName() {
+			user.Password = req.GetNewPassword()
+			user.HashedPassword = ""
+			found = true
+			break
+		}
+	}
+	if !found {
+		return &pb.Empty{}, fmt.Errorf("user %q is not found", req.GetName())
+	}
+	if err := ValidateServerConfigPatch(config); err != nil {
+		return &pb.Empty{}, fmt.Errorf("ValidateServerConfigPatch() failed: %w", err)
+	}
+	if err := storeServerConfigLocked(config); err != nil {
+		return &pb.Empty{}, fmt.Errorf("storeServerConfigLocked() failed: %w", err)
+	}
+	return &pb.Empty{}, nil
+}
+
+// NewUserManagementService creates a new UserManagementService RPC server.
+func NewUserManagementService() *userManagementService {
+	return &userManagementService{}
+}
+
 // GetServerStatusWithRPC gets server application status via ServerLifecycleService.GetStatus() RPC.
 func GetServerStatusWithRPC(ctx context.Context) (*pb.AppStatusMsg, error) {
 	client, err := NewServerLifecycleRPCClient()
@@ -315,7 +949,19 @@ func GetJSONServerConfig() (string, error) {
 func LoadServerConfig() (*pb.ServerConfig, error) {
 	serverIOLock.Lock()
 	defer serverIOLock.Unlock()
+	return loadServerConfigLocked()
+}
+
+// StoreServerConfig writes server config to disk.
+func StoreServerConfig(config *pb.ServerConfig) error {
+	serverIOLock.Lock()
+	defer serverIOLock.Unlock()
+	return storeServerConfigLocked(config)
+}
 
+// loadServerConfigLocked reads server config from disk.
+// Callers must hold serverIOLock.
+func loadServerConfigLocked() (*pb.ServerConfig, error) {
 	err := checkServerConfigDir()
 	if err != nil {
 		return nil, fmt.Errorf("checkServerConfigDir() failed: %w", err)
@@ -350,11 +996,9 @@ func LoadServerConfig() (*pb.ServerConfig, error) {
 	return s, nil
 }
 
-// StoreServerConfig writes server config to disk.
-func StoreServerConfig(config *pb.ServerConfig) error {
-	serverIOLock.Lock()
-	defer serverIOLock.Unlock()
-
+// storeServerConfigLocked writes server config to disk.
+// Callers must hold serverIOLock.
+func storeServerConfigLocked(config *pb.ServerConfig) error {
 	if config == nil {
 		return fmt.Errorf("ServerConfig is nil")
 	}
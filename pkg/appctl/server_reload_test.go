@@ -0,0 +1,146 @@
+// Copyright (C) 2021  mieru authors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package appctl
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/enfein/mieru/pkg/appctl/appctlpb"
+	"github.com/enfein/mieru/pkg/udpsession"
+)
+
+// withTestPortBindings points activePortBindings/udpListeners at a fresh
+// state for the duration of the test, and restores the previous state
+// afterwards.
+func withTestPortBindings(t *testing.T, active map[string]*pb.PortBinding, listeners map[string]*udpsession.Listener) {
+	t.Helper()
+	activePortBindingsMu.Lock()
+	prevActive, prevListeners := activePortBindings, udpListeners
+	activePortBindings, udpListeners = active, listeners
+	activePortBindingsMu.Unlock()
+	t.Cleanup(func() {
+		activePortBindingsMu.Lock()
+		activePortBindings, udpListeners = prevActive, prevListeners
+		activePortBindingsMu.Unlock()
+	})
+}
+
+// TestReloadPortBindingsMarksBrokenOnPartialFailure exercises the case where
+// a binding being removed was tracked in activePortBindings but was never
+// added to GetSocks5ServerGroup(), so socks5.ServerGroup.Remove() fails
+// partway through the removal loop.
+func TestReloadPortBindingsMarksBrokenOnPartialFailure(t *testing.T) {
+	stalePort := 29000
+	withTestPortBindings(t,
+		map[string]*pb.PortBinding{
+			portBindingKey("TCP", stalePort): {Protocol: pb.TransportProtocol_TCP, Port: int32(stalePort)},
+		},
+		map[string]*udpsession.Listener{},
+	)
+	prevStatus := GetAppStatus()
+	t.Cleanup(func() { SetAppStatus(prevStatus) })
+
+	// The new config drops the tracked binding entirely, forcing the removal
+	// loop to call group.Remove() on a binding GetSocks5ServerGroup() never
+	// Add()-ed.
+	err := reloadPortBindings(context.Background(), &pb.ServerConfig{})
+	if err == nil {
+		t.Fatalf("reloadPortBindings() succeeded, want an error from socks5.ServerGroup.Remove() on an untracked binding")
+	}
+	if got := GetAppStatus(); got != pb.AppStatus_BROKEN {
+		t.Fatalf("GetAppStatus() after a failed reload = %v, want AppStatus_BROKEN", got)
+	}
+}
+
+// TestReloadPortBindingsWithNoBindingsSucceeds verifies the degenerate case
+// where neither the running state nor the new config have any port binding:
+// the removal and addition loops both have nothing to do, so reloadPortBindings
+// returns to AppStatus_RUNNING without error.
+func TestReloadPortBindingsWithNoBindingsSucceeds(t *testing.T) {
+	withTestPortBindings(t, map[string]*pb.PortBinding{}, map[string]*udpsession.Listener{})
+	prevStatus := GetAppStatus()
+	t.Cleanup(func() { SetAppStatus(prevStatus) })
+
+	if err := reloadPortBindings(context.Background(), &pb.ServerConfig{}); err != nil {
+		t.Fatalf("reloadPortBindings() failed: %v", err)
+	}
+	if got := GetAppStatus(); got != pb.AppStatus_RUNNING {
+		t.Fatalf("GetAppStatus() = %v, want AppStatus_RUNNING", got)
+	}
+}
+
+// TestReloadPortBindingsKeepsUnchangedBindingListener verifies that a binding
+// present in both the running state and the new config is left alone: its
+// udpsession.Listener is not recreated, only its user list is refreshed.
+func TestReloadPortBindingsKeepsUnchangedBindingListener(t *testing.T) {
+	binding := &pb.PortBinding{Protocol: pb.TransportProtocol_UDP, Port: 0}
+	listener, err := udpsession.ListenWithOptions("127.0.0.1:0", nil)
+	if err != nil {
+		t.Fatalf("udpsession.ListenWithOptions() failed: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	key := portBindingKey(binding.GetProtocol().String(), int(binding.GetPort()))
+
+	withTestPortBindings(t,
+		map[string]*pb.PortBinding{key: binding},
+		map[string]*udpsession.Listener{key: listener},
+	)
+	prevStatus := GetAppStatus()
+	t.Cleanup(func() { SetAppStatus(prevStatus) })
+
+	config := &pb.ServerConfig{
+		PortBindings: []*pb.PortBinding{binding},
+		Users:        usersNamed("alice"),
+	}
+	if err := reloadPortBindings(context.Background(), config); err != nil {
+		t.Fatalf("reloadPortBindings() failed: %v", err)
+	}
+	if got := GetAppStatus(); got != pb.AppStatus_RUNNING {
+		t.Fatalf("GetAppStatus() = %v, want AppStatus_RUNNING", got)
+	}
+	if udpListeners[key] != listener {
+		t.Errorf("udpListeners[%q] was replaced, want the unchanged binding to keep its original listener", key)
+	}
+}
+
+// TestReloadRejectsConcurrentCaller simulates a Reload or SetConfig request
+// that is already in its validate/store/reloadPortBindings sequence by
+// holding reloadMu directly, then verifies a second Reload() call fails fast
+// instead of racing the first one.
+func TestReloadRejectsConcurrentCaller(t *testing.T) {
+	prevStatus := GetAppStatus()
+	t.Cleanup(func() { SetAppStatus(prevStatus) })
+	SetAppStatus(pb.AppStatus_RUNNING)
+
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	svc := &serverLifecycleService{}
+	if _, err := svc.Reload(context.Background(), &pb.ServerConfig{}); err == nil {
+		t.Fatalf("Reload() succeeded while another reload held reloadMu, want error")
+	}
+}
+
+func TestBeginReloadRejectsWhenNotRunning(t *testing.T) {
+	prevStatus := GetAppStatus()
+	t.Cleanup(func() { SetAppStatus(prevStatus) })
+	SetAppStatus(pb.AppStatus_STOPPING)
+
+	if _, err := beginReload(); err == nil {
+		t.Fatalf("beginReload() succeeded while AppStatus != AppStatus_RUNNING, want error")
+	}
+}
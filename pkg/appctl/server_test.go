@@ -0,0 +1,124 @@
+// Copyright (C) 2021  mieru authors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package appctl
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/enfein/mieru/pkg/appctl/appctlpb"
+)
+
+// withTestServerConfigDir points the package's cached server config
+// location at a fresh temp dir for the duration of the test, and restores
+// it afterwards so tests don't interfere with each other.
+func withTestServerConfigDir(t *testing.T) {
+	t.Helper()
+	dir := t.TempDir()
+	prevDir, prevFile := cachedServerConfigDir, cachedServerConfigFilePath
+	cachedServerConfigDir = dir
+	cachedServerConfigFilePath = filepath.Join(dir, "server.conf.pb")
+	t.Cleanup(func() {
+		cachedServerConfigDir, cachedServerConfigFilePath = prevDir, prevFile
+	})
+}
+
+func usersNamed(names ...string) []*pb.User {
+	users := make([]*pb.User, 0, len(names))
+	for _, name := range names {
+		users = append(users, &pb.User{Name: name, Password: "pass"})
+	}
+	return users
+}
+
+func TestListUsersFiltersByNamePrefix(t *testing.T) {
+	withTestServerConfigDir(t)
+	config := &pb.ServerConfig{
+		PortBindings: []*pb.PortBinding{{Protocol: pb.TransportProtocol_TCP, Port: 2012}},
+		Users:        usersNamed("alice", "alex", "bob"),
+	}
+	if err := StoreServerConfig(config); err != nil {
+		t.Fatalf("StoreServerConfig() failed: %v", err)
+	}
+
+	svc := &userManagementService{}
+	resp, err := svc.ListUsers(context.Background(), &pb.ListUsersRequest{NamePrefix: "al"})
+	if err != nil {
+		t.Fatalf("ListUsers() failed: %v", err)
+	}
+	var got []string
+	for _, u := range resp.GetUsers() {
+		got = append(got, u.GetName())
+	}
+	want := []string{"alex", "alice"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("ListUsers() returned %v, want %v", got, want)
+	}
+	if resp.GetNextPageToken() != "" {
+		t.Fatalf("ListUsers() NextPageToken = %q, want empty", resp.GetNextPageToken())
+	}
+}
+
+func TestListUsersPaginates(t *testing.T) {
+	withTestServerConfigDir(t)
+	config := &pb.ServerConfig{
+		PortBindings: []*pb.PortBinding{{Protocol: pb.TransportProtocol_TCP, Port: 2012}},
+		Users:        usersNamed("c", "a", "b"),
+	}
+	if err := StoreServerConfig(config); err != nil {
+		t.Fatalf("StoreServerConfig() failed: %v", err)
+	}
+
+	svc := &userManagementService{}
+	first, err := svc.ListUsers(context.Background(), &pb.ListUsersRequest{PageSize: 2})
+	if err != nil {
+		t.Fatalf("ListUsers() failed: %v", err)
+	}
+	if len(first.GetUsers()) != 2 || first.GetUsers()[0].GetName() != "a" || first.GetUsers()[1].GetName() != "b" {
+		t.Fatalf("first page = %v, want [a b]", first.GetUsers())
+	}
+	if first.GetNextPageToken() == "" {
+		t.Fatalf("first page NextPageToken is empty, want a continuation token")
+	}
+
+	second, err := svc.ListUsers(context.Background(), &pb.ListUsersRequest{PageSize: 2, PageToken: first.GetNextPageToken()})
+	if err != nil {
+		t.Fatalf("ListUsers() failed: %v", err)
+	}
+	if len(second.GetUsers()) != 1 || second.GetUsers()[0].GetName() != "c" {
+		t.Fatalf("second page = %v, want [c]", second.GetUsers())
+	}
+	if second.GetNextPageToken() != "" {
+		t.Fatalf("second page NextPageToken = %q, want empty", second.GetNextPageToken())
+	}
+}
+
+func TestListUsersRejectsOutOfRangePageToken(t *testing.T) {
+	withTestServerConfigDir(t)
+	config := &pb.ServerConfig{
+		PortBindings: []*pb.PortBinding{{Protocol: pb.TransportProtocol_TCP, Port: 2012}},
+		Users:        usersNamed("a"),
+	}
+	if err := StoreServerConfig(config); err != nil {
+		t.Fatalf("StoreServerConfig() failed: %v", err)
+	}
+
+	svc := &userManagementService{}
+	if _, err := svc.ListUsers(context.Background(), &pb.ListUsersRequest{PageToken: "5"}); err == nil {
+		t.Fatalf("ListUsers() with out of range page token succeeded, want error")
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2021  mieru authors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package appctl
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	pb "github.com/enfein/mieru/pkg/appctl/appctlpb"
+	"github.com/enfein/mieru/pkg/log"
+	"google.golang.org/grpc"
+)
+
+// fakeStreamLogsServer implements pb.ServerLifecycleService_StreamLogsServer
+// without a real gRPC connection, so StreamLogs can be driven directly.
+type fakeStreamLogsServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.LogRecord
+}
+
+func newFakeStreamLogsServer(ctx context.Context) *fakeStreamLogsServer {
+	return &fakeStreamLogsServer{ctx: ctx, sent: make(chan *pb.LogRecord, 16)}
+}
+
+func (f *fakeStreamLogsServer) Context() context.Context { return f.ctx }
+
+func (f *fakeStreamLogsServer) Send(record *pb.LogRecord) error {
+	f.sent <- record
+	return nil
+}
+
+func TestStreamLogsFiltersByRequestedLevel(t *testing.T) {
+	// pkg/log exposes no getters for its current level/format/output, so
+	// restore its documented defaults rather than a snapshot.
+	t.Cleanup(func() {
+		log.SetLevel("INFO")
+		log.SetFormat(log.TextFormat)
+		log.SetOutput(os.Stderr)
+	})
+	log.SetOutput(io.Discard)
+	if err := log.SetLevel("DEBUG"); err != nil {
+		t.Fatalf("log.SetLevel() failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newFakeStreamLogsServer(ctx)
+
+	svc := &serverLifecycleService{}
+	done := make(chan error, 1)
+	go func() { done <- svc.StreamLogs(&pb.LogRequest{Level: pb.LoggingLevel_WARN}, stream) }()
+
+	// Give StreamLogs time to call log.Subscribe before records are emitted.
+	time.Sleep(50 * time.Millisecond)
+	log.Infof("dropped, below WARN")
+	log.Warnf("kept, at WARN")
+
+	select {
+	case record := <-stream.sent:
+		if record.GetMessage() != "kept, at WARN" {
+			t.Errorf("first streamed record = %q, want %q", record.GetMessage(), "kept, at WARN")
+		}
+		if record.GetLevel() != "WARN" {
+			t.Errorf("record.Level = %q, want %q", record.GetLevel(), "WARN")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("StreamLogs() did not send the WARN record")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("StreamLogs() returned %v, want nil after context cancellation", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("StreamLogs() did not return after its context was canceled")
+	}
+}
+
+func TestStreamLogsRejectsUnparseableLevel(t *testing.T) {
+	svc := &serverLifecycleService{}
+	stream := newFakeStreamLogsServer(context.Background())
+	if err := svc.StreamLogs(&pb.LogRequest{Level: pb.LoggingLevel(99)}, stream); err == nil {
+		t.Fatalf("StreamLogs() succeeded with an out-of-range LoggingLevel, want error")
+	}
+}
+
+func TestToPBLogRecordStringifiesFields(t *testing.T) {
+	now := time.Now()
+	record := &log.Record{
+		Time:    now,
+		Level:   log.ErrorLevel,
+		Message: "boom",
+		Fields:  log.Fields{"count": 3, "name": "alice"},
+	}
+
+	got := toPBLogRecord(record)
+	if got.GetUnixNano() != now.UnixNano() {
+		t.Errorf("UnixNano = %d, want %d", got.GetUnixNano(), now.UnixNano())
+	}
+	if got.GetLevel() != "ERROR" {
+		t.Errorf("Level = %q, want %q", got.GetLevel(), "ERROR")
+	}
+	if got.GetMessage() != "boom" {
+		t.Errorf("Message = %q, want %q", got.GetMessage(), "boom")
+	}
+	if got.GetFields()["count"] != "3" || got.GetFields()["name"] != "alice" {
+		t.Errorf("Fields = %+v, want count=\"3\" name=\"alice\"", got.GetFields())
+	}
+}
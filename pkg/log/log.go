@@ -0,0 +1,334 @@
+// Copyright (C) 2021  mieru authors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package log is mieru's logging façade. It keeps the package-level
+// Infof/Debugf/... helpers that the rest of the codebase already calls, and
+// adds a structured Logger that carries key/value Fields so a caller can
+// derive a per-request logger (see FromContext / NewContext) and have every
+// line it emits tagged consistently, in either the historical text format or
+// JSONFormat.
+package log
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is logging severity, ordered least to most severe.
+type Level int
+
+const (
+	DebugLevel Level = iota
+	InfoLevel
+	WarnLevel
+	ErrorLevel
+)
+
+func (l Level) String() string {
+	switch l {
+	case DebugLevel:
+		return "DEBUG"
+	case InfoLevel:
+		return "INFO"
+	case WarnLevel:
+		return "WARN"
+	case ErrorLevel:
+		return "ERROR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParseLevel parses a level name as accepted by SetLevel, case insensitively.
+// "DEFAULT" maps to InfoLevel, matching pb.LoggingLevel_DEFAULT.
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToUpper(s) {
+	case "DEBUG":
+		return DebugLevel, nil
+	case "INFO", "DEFAULT":
+		return InfoLevel, nil
+	case "WARN", "WARNING":
+		return WarnLevel, nil
+	case "ERROR":
+		return ErrorLevel, nil
+	default:
+		return InfoLevel, fmt.Errorf("unknown log level %q", s)
+	}
+}
+
+// Format selects how a log line is rendered.
+type Format int
+
+const (
+	// TextFormat reproduces mieru's historical unstructured log lines, so
+	// existing log scrapers keep working.
+	TextFormat Format = iota
+	// JSONFormat renders each log line as a single JSON object, including
+	// any structured Fields attached to it.
+	JSONFormat
+)
+
+// Fields is a set of structured key/value pairs attached to a log line.
+type Fields map[string]interface{}
+
+// Record is one emitted log line. It is also handed to StreamLogs()
+// subscribers.
+type Record struct {
+	Time    time.Time
+	Level   Level
+	Message string
+	Fields  Fields
+}
+
+var (
+	mu     sync.Mutex
+	level  Level     = InfoLevel
+	format Format    = TextFormat
+	output io.Writer = os.Stderr
+
+	subscribersMu sync.Mutex
+	subscribers   = map[int]chan *Record{}
+	nextSubID     int
+
+	requestIDCounter uint64
+)
+
+// SetLevel sets the minimum level of log lines that are emitted.
+func SetLevel(s string) error {
+	lvl, err := ParseLevel(s)
+	if err != nil {
+		return err
+	}
+	mu.Lock()
+	level = lvl
+	mu.Unlock()
+	return nil
+}
+
+// IsLevelEnabled reports whether lvl would currently be emitted.
+func IsLevelEnabled(lvl Level) bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return lvl >= level
+}
+
+// SetFormat selects how subsequent log lines are rendered.
+func SetFormat(f Format) {
+	mu.Lock()
+	format = f
+	mu.Unlock()
+}
+
+// SetOutput redirects where log lines are written. Exposed for tests.
+func SetOutput(w io.Writer) {
+	mu.Lock()
+	output = w
+	mu.Unlock()
+}
+
+// Logger emits log lines carrying a fixed set of contextual Fields.
+type Logger struct {
+	fields Fields
+}
+
+// New returns a Logger with no fields attached.
+func New() *Logger {
+	return &Logger{}
+}
+
+// WithFields returns a copy of the logger with f merged into its fields.
+func (l *Logger) WithFields(f Fields) *Logger {
+	merged := make(Fields, len(l.fields)+len(f))
+	for k, v := range l.fields {
+		merged[k] = v
+	}
+	for k, v := range f {
+		merged[k] = v
+	}
+	return &Logger{fields: merged}
+}
+
+func (l *Logger) Debugf(format string, args ...interface{}) {
+	emit(DebugLevel, fmt.Sprintf(format, args...), l.fields)
+}
+func (l *Logger) Infof(format string, args ...interface{}) {
+	emit(InfoLevel, fmt.Sprintf(format, args...), l.fields)
+}
+func (l *Logger) Warnf(format string, args ...interface{}) {
+	emit(WarnLevel, fmt.Sprintf(format, args...), l.fields)
+}
+func (l *Logger) Errorf(format string, args ...interface{}) {
+	emit(ErrorLevel, fmt.Sprintf(format, args...), l.fields)
+}
+
+// Fatalf logs at ErrorLevel with the logger's fields and then terminates the
+// process, matching the package-level Fatalf behavior.
+func (l *Logger) Fatalf(format string, args ...interface{}) {
+	emit(ErrorLevel, fmt.Sprintf(format, args...), l.fields)
+	os.Exit(1)
+}
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying logger, retrievable with FromContext.
+func NewContext(ctx context.Context, logger *Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, logger)
+}
+
+// FromContext returns the Logger attached to ctx by NewContext, or a fresh
+// Logger with no fields if none was attached.
+func FromContext(ctx context.Context) *Logger {
+	if logger, ok := ctx.Value(ctxKey{}).(*Logger); ok {
+		return logger
+	}
+	return New()
+}
+
+// NextRequestID returns a process-unique, monotonically increasing request
+// id suitable for correlating the log lines of a single RPC call.
+func NextRequestID() string {
+	id := atomicAddRequestIDCounter()
+	return fmt.Sprintf("%d-%d", time.Now().Unix(), id)
+}
+
+func atomicAddRequestIDCounter() uint64 {
+	mu.Lock()
+	requestIDCounter++
+	id := requestIDCounter
+	mu.Unlock()
+	return id
+}
+
+// Subscribe registers for every Record at or above minLevel, for StreamLogs()
+// RPC callers that tee live log output. The returned function unsubscribes
+// and closes the channel; callers must call it to avoid leaking the channel.
+func Subscribe(minLevel Level) (<-chan *Record, func()) {
+	ch := make(chan *Record, 64)
+	subscribersMu.Lock()
+	id := nextSubID
+	nextSubID++
+	subscribers[id] = ch
+	subscribersMu.Unlock()
+
+	unsubscribe := func() {
+		subscribersMu.Lock()
+		delete(subscribers, id)
+		subscribersMu.Unlock()
+		close(ch)
+	}
+
+	filtered := make(chan *Record, 64)
+	go func() {
+		defer close(filtered)
+		for record := range ch {
+			if record.Level >= minLevel {
+				filtered <- record
+			}
+		}
+	}()
+	return filtered, unsubscribe
+}
+
+func broadcast(record *Record) {
+	subscribersMu.Lock()
+	defer subscribersMu.Unlock()
+	for _, ch := range subscribers {
+		select {
+		case ch <- record:
+		default:
+			// Drop the record for slow subscribers rather than blocking the logger.
+		}
+	}
+}
+
+func emit(lvl Level, msg string, fields Fields) {
+	mu.Lock()
+	skip := lvl < level
+	f := format
+	w := output
+	mu.Unlock()
+
+	// Broadcast unconditionally, even below the configured level: a
+	// StreamLogs caller picks its own minLevel via Subscribe, independent of
+	// the level gating the persistent log output written below.
+	record := &Record{Time: time.Now(), Level: lvl, Message: msg, Fields: fields}
+	broadcast(record)
+
+	if skip {
+		return
+	}
+	switch f {
+	case JSONFormat:
+		writeJSON(w, record)
+	default:
+		writeText(w, record)
+	}
+}
+
+func writeText(w io.Writer, record *Record) {
+	line := fmt.Sprintf("%s [%s] %s", record.Time.Format(time.RFC3339), record.Level, record.Message)
+	if len(record.Fields) > 0 {
+		line += " " + formatFieldsText(record.Fields)
+	}
+	fmt.Fprintln(w, line)
+}
+
+func formatFieldsText(fields Fields) string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%v", k, fields[k]))
+	}
+	return strings.Join(parts, " ")
+}
+
+func writeJSON(w io.Writer, record *Record) {
+	b, err := json.Marshal(struct {
+		Time    time.Time `json:"time"`
+		Level   string    `json:"level"`
+		Message string    `json:"message"`
+		Fields  Fields    `json:"fields,omitempty"`
+	}{record.Time, record.Level.String(), record.Message, record.Fields})
+	if err != nil {
+		fmt.Fprintf(w, "%s [%s] %s (failed to marshal fields: %v)\n", record.Time.Format(time.RFC3339), record.Level, record.Message, err)
+		return
+	}
+	fmt.Fprintln(w, string(b))
+}
+
+// Package level helpers preserve mieru's historical unstructured API; they
+// are equivalent to calling the same method on New().
+func Debugf(format string, args ...interface{}) { emit(DebugLevel, fmt.Sprintf(format, args...), nil) }
+func Infof(format string, args ...interface{})  { emit(InfoLevel, fmt.Sprintf(format, args...), nil) }
+func Warnf(format string, args ...interface{})  { emit(WarnLevel, fmt.Sprintf(format, args...), nil) }
+func Errorf(format string, args ...interface{}) { emit(ErrorLevel, fmt.Sprintf(format, args...), nil) }
+
+// Fatalf logs at ErrorLevel and then terminates the process, matching the
+// historical log.Fatalf behavior used throughout mieru.
+func Fatalf(format string, args ...interface{}) {
+	emit(ErrorLevel, fmt.Sprintf(format, args...), nil)
+	os.Exit(1)
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2021  mieru authors
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// withTestState resets the package's mutable singleton state (level, format,
+// output) for the duration of the test and restores it afterwards, so tests
+// don't leak configuration into one another.
+func withTestState(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	mu.Lock()
+	prevLevel, prevFormat, prevOutput := level, format, output
+	mu.Unlock()
+	t.Cleanup(func() {
+		mu.Lock()
+		level, format, output = prevLevel, prevFormat, prevOutput
+		mu.Unlock()
+	})
+
+	var buf bytes.Buffer
+	SetOutput(&buf)
+	return &buf
+}
+
+func TestParseLevel(t *testing.T) {
+	cases := map[string]Level{
+		"debug":   DebugLevel,
+		"DEBUG":   DebugLevel,
+		"info":    InfoLevel,
+		"DEFAULT": InfoLevel,
+		"warn":    WarnLevel,
+		"warning": WarnLevel,
+		"error":   ErrorLevel,
+	}
+	for s, want := range cases {
+		got, err := ParseLevel(s)
+		if err != nil {
+			t.Errorf("ParseLevel(%q) failed: %v", s, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseLevel(%q) = %v, want %v", s, got, want)
+		}
+	}
+	if _, err := ParseLevel("bogus"); err == nil {
+		t.Errorf("ParseLevel(\"bogus\") succeeded, want error")
+	}
+}
+
+func TestLevelGating(t *testing.T) {
+	buf := withTestState(t)
+	if err := SetLevel("WARN"); err != nil {
+		t.Fatalf("SetLevel() failed: %v", err)
+	}
+	if IsLevelEnabled(InfoLevel) {
+		t.Errorf("IsLevelEnabled(InfoLevel) = true, want false after SetLevel(WARN)")
+	}
+	if !IsLevelEnabled(ErrorLevel) {
+		t.Errorf("IsLevelEnabled(ErrorLevel) = false, want true after SetLevel(WARN)")
+	}
+
+	Infof("dropped")
+	if buf.Len() != 0 {
+		t.Errorf("Infof() below the configured level wrote %q, want nothing", buf.String())
+	}
+	Warnf("kept")
+	if !strings.Contains(buf.String(), "kept") {
+		t.Errorf("Warnf() at the configured level wrote %q, want it to contain %q", buf.String(), "kept")
+	}
+}
+
+func TestWriteTextIncludesSortedFields(t *testing.T) {
+	buf := withTestState(t)
+	SetFormat(TextFormat)
+	New().WithFields(Fields{"b": 2, "a": 1}).Infof("hello")
+
+	line := buf.String()
+	if !strings.Contains(line, "[INFO] hello") {
+		t.Errorf("text line = %q, want it to contain %q", line, "[INFO] hello")
+	}
+	if idx := strings.Index(line, "a=1"); idx == -1 || !strings.Contains(line[idx:], "b=2") {
+		t.Errorf("text line = %q, want fields in sorted key order (a=1 before b=2)", line)
+	}
+}
+
+func TestWriteJSONIncludesFields(t *testing.T) {
+	buf := withTestState(t)
+	SetFormat(JSONFormat)
+	New().WithFields(Fields{"request_id": "42"}).Errorf("boom")
+
+	var decoded struct {
+		Level   string            `json:"level"`
+		Message string            `json:"message"`
+		Fields  map[string]string `json:"fields"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(%q) failed: %v", buf.String(), err)
+	}
+	if decoded.Level != "ERROR" || decoded.Message != "boom" || decoded.Fields["request_id"] != "42" {
+		t.Errorf("decoded JSON record = %+v, want level ERROR, message boom, fields[request_id]=42", decoded)
+	}
+}
+
+func TestSubscribeReceivesRecordsRegardlessOfLevel(t *testing.T) {
+	withTestState(t)
+	if err := SetLevel("ERROR"); err != nil {
+		t.Fatalf("SetLevel() failed: %v", err)
+	}
+	records, unsubscribe := Subscribe(DebugLevel)
+	defer unsubscribe()
+
+	Infof("still streamed")
+	select {
+	case record := <-records:
+		if record.Message != "still streamed" {
+			t.Errorf("record.Message = %q, want %q", record.Message, "still streamed")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Subscribe() did not receive a record below the persistent log level")
+	}
+}
+
+func TestSubscribeFiltersByMinLevel(t *testing.T) {
+	withTestState(t)
+	records, unsubscribe := Subscribe(WarnLevel)
+	defer unsubscribe()
+
+	Infof("below min level")
+	Warnf("at min level")
+
+	select {
+	case record := <-records:
+		if record.Message != "at min level" {
+			t.Errorf("first record = %q, want %q", record.Message, "at min level")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Subscribe(WarnLevel) did not receive the WARN record")
+	}
+
+	select {
+	case record := <-records:
+		t.Errorf("Subscribe(WarnLevel) unexpectedly received %q", record.Message)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBroadcastDropsRecordsForSlowSubscribers(t *testing.T) {
+	withTestState(t)
+	records, unsubscribe := Subscribe(DebugLevel)
+	defer unsubscribe()
+
+	// A subscriber can hold at most 129 undrained records: 64 in its
+	// broadcast channel, 64 in the filtered channel, and one held by the
+	// forwarding goroutine. Emit more than that without draining:
+	// broadcast must drop the excess instead of blocking the logger.
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 200; i++ {
+			Infof("record %d", i)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("emitting 200 records blocked; broadcast should drop instead of blocking")
+	}
+	// Give the Subscribe() forwarding goroutine a moment to drain ch into
+	// filtered before we read filtered below.
+	time.Sleep(50 * time.Millisecond)
+
+	drained := 0
+	for {
+		select {
+		case <-records:
+			drained++
+		default:
+			if drained >= 200 {
+				t.Errorf("drained all %d records, want broadcast to have dropped some for the unread subscriber", drained)
+			}
+			return
+		}
+	}
+}